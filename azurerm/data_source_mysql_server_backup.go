@@ -0,0 +1,120 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmMySqlServerBackup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMySqlServerBackupRead,
+
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAzureResourceID,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"backup_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"completed_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMySqlServerBackupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlServerBackupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	serverID := d.Get("server_id").(string)
+	id, err := parseAzureResourceID(serverID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["flexibleServers"]
+
+	resp, err := client.ListByServer(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error listing Backups for MySQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	nameFilter, filterByName := d.GetOk("name")
+
+	backups := make([]interface{}, 0)
+	for resp.NotDone() {
+		for _, backup := range resp.Values() {
+			name := ""
+			if backup.Name != nil {
+				name = *backup.Name
+			}
+
+			if filterByName && name != nameFilter.(string) {
+				continue
+			}
+
+			values := map[string]interface{}{
+				"name": name,
+			}
+
+			if props := backup.ServerBackupProperties; props != nil {
+				values["backup_type"] = string(props.BackupType)
+				if props.Source != nil {
+					values["source"] = *props.Source
+				}
+				if props.CompletedTime != nil {
+					values["completed_time"] = props.CompletedTime.String()
+				}
+			}
+
+			backups = append(backups, values)
+		}
+
+		if err := resp.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Backups for MySQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+	}
+
+	if filterByName && len(backups) == 0 {
+		return fmt.Errorf("Backup %q was not found for MySQL Server %q (Resource Group %q)", nameFilter.(string), serverName, resourceGroup)
+	}
+
+	if err := d.Set("backups", backups); err != nil {
+		return fmt.Errorf("Error setting `backups`: %+v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/backups", serverID))
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMySqlAdministrator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMySqlAdministratorCreateUpdate,
+		Update: resourceArmMySqlAdministratorCreateUpdate,
+		Read:   resourceArmMySqlAdministratorRead,
+		Delete: resourceArmMySqlAdministratorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"login": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func resourceArmMySqlAdministratorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlServerAdministratorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM MySQL Active Directory Administrator creation.")
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	login := d.Get("login").(string)
+	objectId := d.Get("object_id").(string)
+	tenantId := d.Get("tenant_id").(string)
+
+	sid, err := uuid.FromString(objectId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `object_id` %q as a UUID: %+v", objectId, err)
+	}
+
+	tid, err := uuid.FromString(tenantId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `tenant_id` %q as a UUID: %+v", tenantId, err)
+	}
+
+	parameters := mysql.ServerAdministratorResource{
+		ServerAdministratorProperties: &mysql.ServerAdministratorProperties{
+			AdministratorType: utils.String("ActiveDirectory"),
+			Login:             utils.String(login),
+			Sid:               &sid,
+			TenantID:          &tid,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating MySQL Active Directory Administrator (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of MySQL Active Directory Administrator (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving MySQL Active Directory Administrator (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read MySQL Active Directory Administrator (Server %q / Resource Group %q) ID", serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMySqlAdministratorRead(d, meta)
+}
+
+func resourceArmMySqlAdministratorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlServerAdministratorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] MySQL Active Directory Administrator (Server %q) does not exist - removing from state", serverName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure MySQL Active Directory Administrator (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	if props := resp.ServerAdministratorProperties; props != nil {
+		d.Set("login", props.Login)
+
+		if props.Sid != nil {
+			d.Set("object_id", props.Sid.String())
+		}
+
+		if props.TenantID != nil {
+			d.Set("tenant_id", props.TenantID.String())
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMySqlAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlServerAdministratorsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+
+	future, err := client.Delete(ctx, resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error deleting MySQL Active Directory Administrator (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of MySQL Active Directory Administrator (Server %q / Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	return nil
+}
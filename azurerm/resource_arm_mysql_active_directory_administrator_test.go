@@ -0,0 +1,123 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMySqlAdministrator_basic(t *testing.T) {
+	resourceName := "azurerm_mysql_active_directory_administrator.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySqlAdministrator_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySqlAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySqlAdministratorExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMySqlAdministratorExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).mysqlServerAdministratorsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: MySQL Active Directory Administrator (Server %q / Resource Group %q) does not exist", serverName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on mysqlServerAdministratorsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMySqlAdministratorDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).mysqlServerAdministratorsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mysql_active_directory_administrator" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("MySQL Active Directory Administrator still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMySqlAdministrator_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_mysql_server" "test" {
+  name                = "acctestmysqlsvr-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "B_Gen5_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb = 51200
+  }
+
+  administrator_login          = "acctestun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "5.7"
+  ssl_enforcement               = "Enabled"
+}
+
+resource "azurerm_mysql_active_directory_administrator" "test" {
+  server_name         = azurerm_mysql_server.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  login               = "sqladmin"
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  object_id           = data.azurerm_client_config.current.object_id
+}
+`, rInt, location, rInt)
+}
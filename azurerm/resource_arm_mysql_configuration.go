@@ -0,0 +1,152 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMySqlConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMySqlConfigurationCreateUpdate,
+		Update: resourceArmMySqlConfigurationCreateUpdate,
+		Read:   resourceArmMySqlConfigurationRead,
+		Delete: resourceArmMySqlConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceArmMySqlConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlConfigurationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM MySQL Configuration creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	value := d.Get("value").(string)
+
+	properties := mysql.Configuration{
+		ConfigurationProperties: &mysql.ConfigurationProperties{
+			Value: utils.String(value),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating MySQL Configuration %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of MySQL Configuration %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving MySQL Configuration %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read MySQL Configuration %q (Server %q / Resource Group %q) ID", name, serverName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMySqlConfigurationRead(d, meta)
+}
+
+func resourceArmMySqlConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlConfigurationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["configurations"]
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] MySQL Configuration %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure MySQL Configuration %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	if props := resp.ConfigurationProperties; props != nil {
+		d.Set("value", props.Value)
+	}
+
+	return nil
+}
+
+func resourceArmMySqlConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlConfigurationsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["configurations"]
+
+	// "Delete" resets the configuration value back to the default, there's no real delete available
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving MySQL Configuration %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	properties := mysql.Configuration{
+		ConfigurationProperties: &mysql.ConfigurationProperties{
+			Value:  resp.DefaultValue,
+			Source: utils.String("system-default"),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
+	if err != nil {
+		return fmt.Errorf("Error resetting MySQL Configuration %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for reset of MySQL Configuration %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,647 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2020-07-01-preview/mysqlflexibleservers"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMySqlFlexibleServer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMySqlFlexibleServerCreate,
+		Read:   resourceArmMySqlFlexibleServerRead,
+		Update: resourceArmMySqlFlexibleServerUpdate,
+		Delete: resourceArmMySqlFlexibleServerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"administrator_login": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"administrator_login_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"B_Standard_B1s",
+					"B_Standard_B1ms",
+					"B_Standard_B2s",
+					"GP_Standard_D2ds_v4",
+					"GP_Standard_D4ds_v4",
+					"GP_Standard_D8ds_v4",
+					"MO_Standard_E2ds_v4",
+					"MO_Standard_E4ds_v4",
+					"MO_Standard_E8ds_v4",
+				}, false),
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"5.7",
+					"8.0.21",
+				}, false),
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"delegated_subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureResourceID,
+			},
+
+			"private_dns_zone_resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureResourceID,
+			},
+
+			"create_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(mysqlflexibleservers.CreateModeDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(mysqlflexibleservers.CreateModeDefault),
+					string(mysqlflexibleservers.CreateModePointInTimeRestore),
+				}, false),
+			},
+
+			"source_server_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAzureResourceID,
+			},
+
+			"point_in_time_restore_time_in_utc": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"high_availability": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(mysqlflexibleservers.ZoneRedundant),
+								string(mysqlflexibleservers.SameZone),
+							}, false),
+						},
+
+						"standby_availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"storage": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_size_gb": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(20, 16384),
+						},
+
+						"iops": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+
+						"auto_grow_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"backup": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_retention_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      7,
+							ValidateFunc: validation.IntBetween(1, 35),
+						},
+
+						"geo_redundant_backup_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"maintenance_window": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"day_of_week": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 6),
+						},
+
+						"start_hour": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+
+						"start_minute": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMySqlFlexibleServerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlFlexibleServersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM MySQL Flexible Server creation.")
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	createMode := d.Get("create_mode").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for presence of existing MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+	if existing.ID != nil && *existing.ID != "" {
+		return fmt.Errorf("A MySQL Flexible Server named %q (Resource Group %q) already exists - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information", name, resourceGroup, "azurerm_mysql_flexible_server")
+	}
+
+	properties := mysqlflexibleservers.Server{
+		Location: utils.String(location),
+		Sku:      expandMySQLFlexibleServerSku(d.Get("sku_name").(string)),
+		Tags:     expandTags(tags),
+	}
+
+	switch mysqlflexibleservers.CreateMode(createMode) {
+	case mysqlflexibleservers.CreateModePointInTimeRestore:
+		sourceServerID, ok := d.GetOk("source_server_id")
+		if !ok {
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `PointInTimeRestore`")
+		}
+		restoreTime, ok := d.GetOk("point_in_time_restore_time_in_utc")
+		if !ok {
+			return fmt.Errorf("`point_in_time_restore_time_in_utc` is required when `create_mode` is `PointInTimeRestore`")
+		}
+		restorePointInTime, err := time.Parse(time.RFC3339, restoreTime.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `point_in_time_restore_time_in_utc` %q: %+v", restoreTime.(string), err)
+		}
+		properties.ServerProperties = &mysqlflexibleservers.ServerProperties{
+			CreateMode:         mysqlflexibleservers.CreateModePointInTimeRestore,
+			SourceServerResourceID: utils.String(sourceServerID.(string)),
+			RestorePointInTime: &date.Time{Time: restorePointInTime},
+		}
+	default:
+		adminLogin, ok := d.GetOk("administrator_login")
+		if !ok {
+			return fmt.Errorf("`administrator_login` is required when `create_mode` is `Default`")
+		}
+		adminPassword, ok := d.GetOk("administrator_login_password")
+		if !ok {
+			return fmt.Errorf("`administrator_login_password` is required when `create_mode` is `Default`")
+		}
+
+		serverProperties := &mysqlflexibleservers.ServerProperties{
+			CreateMode:                 mysqlflexibleservers.CreateModeDefault,
+			AdministratorLogin:         utils.String(adminLogin.(string)),
+			AdministratorLoginPassword: utils.String(adminPassword.(string)),
+			Version:                    mysqlflexibleservers.ServerVersion(d.Get("version").(string)),
+			Storage:                    expandMySQLFlexibleServerStorage(d.Get("storage").([]interface{})),
+			Backup:                     expandMySQLFlexibleServerBackup(d.Get("backup").([]interface{})),
+			HighAvailability:           expandMySQLFlexibleServerHighAvailability(d.Get("high_availability").([]interface{})),
+			Network: &mysqlflexibleservers.Network{
+				DelegatedSubnetResourceID: utils.String(d.Get("delegated_subnet_id").(string)),
+				PrivateDNSZoneResourceID:  utils.String(d.Get("private_dns_zone_resource_id").(string)),
+			},
+			MaintenanceWindow: expandMySQLFlexibleServerMaintenanceWindow(d.Get("maintenance_window").([]interface{})),
+		}
+
+		if zone, ok := d.GetOk("zone"); ok {
+			serverProperties.AvailabilityZone = utils.String(zone.(string))
+		}
+
+		properties.ServerProperties = serverProperties
+	}
+
+	future, err := client.Create(ctx, resourceGroup, name, properties)
+	if err != nil {
+		return fmt.Errorf("Error creating MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read MySQL Flexible Server %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMySqlFlexibleServerRead(d, meta)
+}
+
+func resourceArmMySqlFlexibleServerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlFlexibleServersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM MySQL Flexible Server update.")
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["flexibleServers"]
+
+	properties := mysqlflexibleservers.ServerForUpdate{
+		ServerPropertiesForUpdate: &mysqlflexibleservers.ServerPropertiesForUpdate{
+			Storage:           expandMySQLFlexibleServerStorage(d.Get("storage").([]interface{})),
+			Backup:            expandMySQLFlexibleServerBackup(d.Get("backup").([]interface{})),
+			HighAvailability:  expandMySQLFlexibleServerHighAvailability(d.Get("high_availability").([]interface{})),
+			MaintenanceWindow: expandMySQLFlexibleServerMaintenanceWindow(d.Get("maintenance_window").([]interface{})),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if d.HasChange("administrator_login_password") {
+		properties.ServerPropertiesForUpdate.AdministratorLoginPassword = utils.String(d.Get("administrator_login_password").(string))
+	}
+
+	if d.HasChange("sku_name") {
+		properties.Sku = expandMySQLFlexibleServerSku(d.Get("sku_name").(string))
+	}
+
+	future, err := client.Update(ctx, resourceGroup, name, properties)
+	if err != nil {
+		return fmt.Errorf("Error updating MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmMySqlFlexibleServerRead(d, meta)
+}
+
+func resourceArmMySqlFlexibleServerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlFlexibleServersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["flexibleServers"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] MySQL Flexible Server %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku_name", sku.Name)
+	}
+
+	if props := resp.ServerProperties; props != nil {
+		d.Set("administrator_login", props.AdministratorLogin)
+		d.Set("version", string(props.Version))
+		d.Set("fqdn", props.FullyQualifiedDomainName)
+		d.Set("zone", props.AvailabilityZone)
+
+		if network := props.Network; network != nil {
+			d.Set("delegated_subnet_id", network.DelegatedSubnetResourceID)
+			d.Set("private_dns_zone_resource_id", network.PrivateDNSZoneResourceID)
+		}
+
+		if err := d.Set("storage", flattenMySQLFlexibleServerStorage(props.Storage)); err != nil {
+			return fmt.Errorf("Error setting `storage`: %+v", err)
+		}
+
+		if err := d.Set("backup", flattenMySQLFlexibleServerBackup(props.Backup)); err != nil {
+			return fmt.Errorf("Error setting `backup`: %+v", err)
+		}
+
+		if err := d.Set("high_availability", flattenMySQLFlexibleServerHighAvailability(props.HighAvailability)); err != nil {
+			return fmt.Errorf("Error setting `high_availability`: %+v", err)
+		}
+
+		if err := d.Set("maintenance_window", flattenMySQLFlexibleServerMaintenanceWindow(props.MaintenanceWindow)); err != nil {
+			return fmt.Errorf("Error setting `maintenance_window`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmMySqlFlexibleServerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlFlexibleServersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["flexibleServers"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of MySQL Flexible Server %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandMySQLFlexibleServerSku(name string) *mysqlflexibleservers.Sku {
+	var tier mysqlflexibleservers.SkuTier
+	switch name[0:2] {
+	case "B_":
+		tier = mysqlflexibleservers.Burstable
+	case "MO":
+		tier = mysqlflexibleservers.MemoryOptimized
+	default:
+		tier = mysqlflexibleservers.GeneralPurpose
+	}
+
+	return &mysqlflexibleservers.Sku{
+		Name: utils.String(name),
+		Tier: tier,
+	}
+}
+
+func expandMySQLFlexibleServerStorage(input []interface{}) *mysqlflexibleservers.Storage {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	storage := mysqlflexibleservers.Storage{
+		AutoGrow: mysqlflexibleservers.StorageAutoGrowDisabled,
+	}
+
+	if v["auto_grow_enabled"].(bool) {
+		storage.AutoGrow = mysqlflexibleservers.StorageAutoGrowEnabled
+	}
+	if size, ok := v["storage_size_gb"]; ok && size.(int) != 0 {
+		storage.StorageSizeGB = utils.Int32(int32(size.(int)))
+	}
+	if iops, ok := v["iops"]; ok && iops.(int) != 0 {
+		storage.Iops = utils.Int32(int32(iops.(int)))
+	}
+
+	return &storage
+}
+
+func expandMySQLFlexibleServerBackup(input []interface{}) *mysqlflexibleservers.Backup {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	backup := mysqlflexibleservers.Backup{
+		BackupRetentionDays: utils.Int32(int32(v["backup_retention_days"].(int))),
+		GeoRedundantBackup:  mysqlflexibleservers.Disabled,
+	}
+
+	if v["geo_redundant_backup_enabled"].(bool) {
+		backup.GeoRedundantBackup = mysqlflexibleservers.Enabled
+	}
+
+	return &backup
+}
+
+func expandMySQLFlexibleServerHighAvailability(input []interface{}) *mysqlflexibleservers.HighAvailability {
+	if len(input) == 0 || input[0] == nil {
+		return &mysqlflexibleservers.HighAvailability{
+			Mode: mysqlflexibleservers.HighAvailabilityModeDisabled,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	ha := mysqlflexibleservers.HighAvailability{
+		Mode: mysqlflexibleservers.HighAvailabilityMode(v["mode"].(string)),
+	}
+
+	if zone, ok := v["standby_availability_zone"]; ok && zone.(string) != "" {
+		ha.StandbyAvailabilityZone = utils.String(zone.(string))
+	}
+
+	return &ha
+}
+
+func expandMySQLFlexibleServerMaintenanceWindow(input []interface{}) *mysqlflexibleservers.MaintenanceWindow {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &mysqlflexibleservers.MaintenanceWindow{
+		CustomWindow: utils.String("Enabled"),
+		DayOfWeek:    utils.Int32(int32(v["day_of_week"].(int))),
+		StartHour:    utils.Int32(int32(v["start_hour"].(int))),
+		StartMinute:  utils.Int32(int32(v["start_minute"].(int))),
+	}
+}
+
+func flattenMySQLFlexibleServerStorage(input *mysqlflexibleservers.Storage) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var storageSizeGB, iops int
+	if input.StorageSizeGB != nil {
+		storageSizeGB = int(*input.StorageSizeGB)
+	}
+	if input.Iops != nil {
+		iops = int(*input.Iops)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_size_gb":   storageSizeGB,
+			"iops":              iops,
+			"auto_grow_enabled": input.AutoGrow == mysqlflexibleservers.StorageAutoGrowEnabled,
+		},
+	}
+}
+
+func flattenMySQLFlexibleServerBackup(input *mysqlflexibleservers.Backup) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var retentionDays int
+	if input.BackupRetentionDays != nil {
+		retentionDays = int(*input.BackupRetentionDays)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"backup_retention_days":        retentionDays,
+			"geo_redundant_backup_enabled": input.GeoRedundantBackup == mysqlflexibleservers.Enabled,
+		},
+	}
+}
+
+func flattenMySQLFlexibleServerHighAvailability(input *mysqlflexibleservers.HighAvailability) []interface{} {
+	if input == nil || input.Mode == mysqlflexibleservers.HighAvailabilityModeDisabled {
+		return []interface{}{}
+	}
+
+	standbyZone := ""
+	if input.StandbyAvailabilityZone != nil {
+		standbyZone = *input.StandbyAvailabilityZone
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                      string(input.Mode),
+			"standby_availability_zone": standbyZone,
+		},
+	}
+}
+
+func flattenMySQLFlexibleServerMaintenanceWindow(input *mysqlflexibleservers.MaintenanceWindow) []interface{} {
+	if input == nil || input.CustomWindow == nil || *input.CustomWindow != "Enabled" {
+		return []interface{}{}
+	}
+
+	var dayOfWeek, startHour, startMinute int
+	if input.DayOfWeek != nil {
+		dayOfWeek = int(*input.DayOfWeek)
+	}
+	if input.StartHour != nil {
+		startHour = int(*input.StartHour)
+	}
+	if input.StartMinute != nil {
+		startMinute = int(*input.StartMinute)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"day_of_week":  dayOfWeek,
+			"start_hour":   startHour,
+			"start_minute": startMinute,
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMySqlFlexibleServer_basic(t *testing.T) {
+	resourceName := "azurerm_mysql_flexible_server.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySqlFlexibleServer_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySqlFlexibleServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySqlFlexibleServerExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMySqlFlexibleServer_highAvailability(t *testing.T) {
+	resourceName := "azurerm_mysql_flexible_server.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySqlFlexibleServer_highAvailability(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySqlFlexibleServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySqlFlexibleServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "high_availability.0.mode", "ZoneRedundant"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMySqlFlexibleServerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).mysqlFlexibleServersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: MySQL Flexible Server %q (Resource Group %q) does not exist", serverName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on mysqlFlexibleServersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMySqlFlexibleServerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).mysqlFlexibleServersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mysql_flexible_server" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("MySQL Flexible Server still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMySqlFlexibleServer_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "acctestun"
+  administrator_login_password = "QAZwsx123"
+  sku_name               = "B_Standard_B1s"
+
+  storage {
+    storage_size_gb = 20
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMySqlFlexibleServer_highAvailability(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                          = "acctest-fs-%d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  administrator_login           = "acctestun"
+  administrator_login_password  = "QAZwsx123"
+  sku_name                      = "GP_Standard_D2ds_v4"
+
+  storage {
+    storage_size_gb = 32
+  }
+
+  high_availability {
+    mode = "ZoneRedundant"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -3,8 +3,10 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -20,6 +22,9 @@ func resourceArmMySqlServer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		SchemaVersion: 1,
+		MigrateState:  resourceAzureRMMySqlServerMigrateState,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -100,15 +105,17 @@ func resourceArmMySqlServer() *schema.Resource {
 			},
 
 			"administrator_login": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_server_id"},
 			},
 
 			"administrator_login_password": {
-				Type:      schema.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"source_server_id"},
 			},
 
 			"version": {
@@ -152,9 +159,9 @@ func resourceArmMySqlServer() *schema.Resource {
 							}),
 						},
 
-						"backupRetentionDays": {
+						"backup_retention_days": {
 							Type:     schema.TypeInt,
-							Required: false,
+							Optional: true,
 							ValidateFunc: validateIntInSlice([]int{
 								7,
 								8,
@@ -188,9 +195,9 @@ func resourceArmMySqlServer() *schema.Resource {
 							}),
 						},
 
-						"georedundantbackup": {
+						"geo_redundant_backup": {
 							Type:     schema.TypeString,
-							Required: false,
+							Optional: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"Enabled",
 								"Disabled",
@@ -211,16 +218,33 @@ func resourceArmMySqlServer() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
-			"createmode": {
+			"create_mode": {
 				Type:     schema.TypeString,
-				Required: false,
+				Optional: true,
+				Default:  string(mysql.CreateModeDefault),
 				ValidateFunc: validation.StringInSlice([]string{
-					"Default",
-					"PointInTimeRestore",
+					string(mysql.CreateModeDefault),
+					string(mysql.CreateModePointInTimeRestore),
+					string(mysql.CreateModeGeoRestore),
+					string(mysql.CreateModeReplica),
 				}, true),
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"source_server_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateAzureResourceID,
+				ConflictsWith: []string{"administrator_login", "administrator_login_password"},
+			},
+
+			"restore_point_in_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				RequiredWith: []string{"source_server_id"},
+			},
+
 			"fqdn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -241,28 +265,99 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 	location := d.Get("location").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	adminLogin := d.Get("administrator_login").(string)
-	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
-	createMode := d.Get("createmode").(string)
+	createMode := mysql.CreateMode(d.Get("create_mode").(string))
 	tags := d.Get("tags").(map[string]interface{})
 
-	sku := expandMySQLServerSku(d)
-	storageprofile := expandMySQLStorageProfile(d)
+	sku, err := expandMySQLServerSku(d.Get("sku").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
 
-	properties := mysql.ServerForCreate{
-		Location: &location,
-		Properties: &mysql.ServerPropertiesForDefaultCreate{
-			AdministratorLogin:         utils.String(adminLogin),
-			AdministratorLoginPassword: utils.String(adminLoginPassword),
-			Version:                    mysql.ServerVersion(version),
-			SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
-			StorageProfile:             storageprofile,
-			CreateMode:                 mysql.CreateMode(createMode),
-		},
-		Sku:  sku,
-		Tags: expandTags(tags),
+	storageprofile, err := expandMySQLStorageProfile(d.Get("storage_profile").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	var properties mysql.ServerForCreate
+	switch createMode {
+	case mysql.CreateModePointInTimeRestore:
+		sourceServerID, restorePointInTime, err := mysqlServerRestoreSettings(d, createMode)
+		if err != nil {
+			return err
+		}
+
+		properties = mysql.ServerForCreate{
+			Location: &location,
+			Properties: &mysql.ServerPropertiesForRestore{
+				SourceServerID:     utils.String(sourceServerID),
+				RestorePointInTime: &date.Time{Time: *restorePointInTime},
+				Version:            mysql.ServerVersion(version),
+				SslEnforcement:     mysql.SslEnforcementEnum(sslEnforcement),
+				StorageProfile:     storageprofile,
+				CreateMode:         createMode,
+			},
+			Sku:  sku,
+			Tags: expandTags(tags),
+		}
+	case mysql.CreateModeGeoRestore:
+		sourceServerID, ok := d.GetOk("source_server_id")
+		if !ok {
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `GeoRestore`")
+		}
+
+		properties = mysql.ServerForCreate{
+			Location: &location,
+			Properties: &mysql.ServerPropertiesForGeoRestore{
+				SourceServerID: utils.String(sourceServerID.(string)),
+				Version:        mysql.ServerVersion(version),
+				SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
+				StorageProfile: storageprofile,
+				CreateMode:     createMode,
+			},
+			Sku:  sku,
+			Tags: expandTags(tags),
+		}
+	case mysql.CreateModeReplica:
+		sourceServerID, ok := d.GetOk("source_server_id")
+		if !ok {
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `Replica`")
+		}
+
+		properties = mysql.ServerForCreate{
+			Location: &location,
+			Properties: &mysql.ServerPropertiesForReplica{
+				SourceServerID: utils.String(sourceServerID.(string)),
+				SslEnforcement: mysql.SslEnforcementEnum(sslEnforcement),
+				CreateMode:     createMode,
+			},
+			Sku:  sku,
+			Tags: expandTags(tags),
+		}
+	default:
+		adminLogin, ok := d.GetOk("administrator_login")
+		if !ok {
+			return fmt.Errorf("`administrator_login` is required when `create_mode` is `Default`")
+		}
+		adminLoginPassword, ok := d.GetOk("administrator_login_password")
+		if !ok {
+			return fmt.Errorf("`administrator_login_password` is required when `create_mode` is `Default`")
+		}
+
+		properties = mysql.ServerForCreate{
+			Location: &location,
+			Properties: &mysql.ServerPropertiesForDefaultCreate{
+				AdministratorLogin:         utils.String(adminLogin.(string)),
+				AdministratorLoginPassword: utils.String(adminLoginPassword.(string)),
+				Version:                    mysql.ServerVersion(version),
+				SslEnforcement:             mysql.SslEnforcementEnum(sslEnforcement),
+				StorageProfile:             storageprofile,
+				CreateMode:                 createMode,
+			},
+			Sku:  sku,
+			Tags: expandTags(tags),
+		}
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, properties)
@@ -300,10 +395,18 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 	adminLoginPassword := d.Get("administrator_login_password").(string)
 	sslEnforcement := d.Get("ssl_enforcement").(string)
 	version := d.Get("version").(string)
-	sku := expandMySQLServerSku(d)
-	storageprofile := expandMySQLStorageProfile(d)
 	tags := d.Get("tags").(map[string]interface{})
 
+	sku, err := expandMySQLServerSku(d.Get("sku").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	storageprofile, err := expandMySQLStorageProfile(d.Get("storage_profile").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
 	properties := mysql.ServerUpdateParameters{
 		ServerUpdateParametersProperties: &mysql.ServerUpdateParametersProperties{
 			StorageProfile:             storageprofile,
@@ -369,12 +472,12 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("version", string(resp.Version))
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 
-	if err := d.Set("sku", flattenMySQLServerSku(d, resp.Sku)); err != nil {
-		return err
+	if err := d.Set("sku", flattenMySQLServerSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
 
-	if err := d.Set("server_profile", flattenMySQLStorageProfile(d, resp.StorageProfile)); err != nil {
-		return err
+	if err := d.Set("storage_profile", flattenMySQLStorageProfile(resp.StorageProfile)); err != nil {
+		return fmt.Errorf("Error setting `storage_profile`: %+v", err)
 	}
 
 	flattenAndSetTags(d, resp.Tags)
@@ -409,9 +512,31 @@ func resourceArmMySqlServerDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func expandMySQLServerSku(d *schema.ResourceData) *mysql.Sku {
-	skus := d.Get("sku").(*schema.Set).List()
-	sku := skus[0].(map[string]interface{})
+func mysqlServerRestoreSettings(d *schema.ResourceData, createMode mysql.CreateMode) (string, *time.Time, error) {
+	sourceServerID, ok := d.GetOk("source_server_id")
+	if !ok {
+		return "", nil, fmt.Errorf("`source_server_id` is required when `create_mode` is %q", createMode)
+	}
+
+	restorePointInTimeRaw, ok := d.GetOk("restore_point_in_time")
+	if !ok {
+		return "", nil, fmt.Errorf("`restore_point_in_time` is required when `create_mode` is %q", createMode)
+	}
+
+	restorePointInTime, err := time.Parse(time.RFC3339, restorePointInTimeRaw.(string))
+	if err != nil {
+		return "", nil, fmt.Errorf("Error parsing `restore_point_in_time` %q: %+v", restorePointInTimeRaw.(string), err)
+	}
+
+	return sourceServerID.(string), &restorePointInTime, nil
+}
+
+func expandMySQLServerSku(input []interface{}) (*mysql.Sku, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("`sku` must have one element")
+	}
+
+	sku := input[0].(map[string]interface{})
 
 	name := sku["name"].(string)
 	capacity := sku["capacity"].(int)
@@ -423,43 +548,80 @@ func expandMySQLServerSku(d *schema.ResourceData) *mysql.Sku {
 		Tier:     mysql.SkuTier(tier),
 		Capacity: utils.Int32(int32(capacity)),
 		Family:   utils.String(family),
-	}
+	}, nil
 }
 
-func expandMySQLStorageProfile(d *schema.ResourceData) *mysql.StorageProfile {
-	storageprofiles := d.Get("storageprofile").(*schema.Set).List()
-	storageprofile := storageprofiles[0].(map[string]interface{})
+func expandMySQLStorageProfile(input []interface{}) (*mysql.StorageProfile, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, fmt.Errorf("`storage_profile` must have one element")
+	}
+
+	storageprofile := input[0].(map[string]interface{})
+
+	storageMB := storageprofile["storage_mb"].(int)
+
+	profile := mysql.StorageProfile{
+		StorageMB: utils.Int32(int32(storageMB)),
+	}
 
-	backupRetentionDays := storageprofile["backupretentiondays"].(int)
-	geoRedundantBackup := storageprofile["geoRedundantBackup"].(string)
-	storageMB := storageprofile["storageMB"].(int)
+	if backupRetentionDays, ok := storageprofile["backup_retention_days"]; ok && backupRetentionDays.(int) != 0 {
+		profile.BackupRetentionDays = utils.Int32(int32(backupRetentionDays.(int)))
+	}
 
-	return &mysql.StorageProfile{
-		BackupRetentionDays: utils.Int32(int32(backupRetentionDays)),
-		StorageMB:           utils.Int32(int32(storageMB)),
-		GeoRedundantBackup:  mysql.GeoRedundantBackup(geoRedundantBackup),
+	if geoRedundantBackup, ok := storageprofile["geo_redundant_backup"]; ok && geoRedundantBackup.(string) != "" {
+		profile.GeoRedundantBackup = mysql.GeoRedundantBackup(geoRedundantBackup.(string))
 	}
+
+	return &profile, nil
 }
 
-func flattenMySQLServerSku(d *schema.ResourceData, resp *mysql.Sku) []interface{} {
-	values := map[string]interface{}{}
+func flattenMySQLServerSku(input *mysql.Sku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var name, tier, family string
+	var capacity int
 
-	values["name"] = *resp.Name
-	values["capacity"] = int(*resp.Capacity)
-	values["tier"] = string(resp.Tier)
-	values["family"] = string(*resp.Family)
+	if input.Name != nil {
+		name = *input.Name
+	}
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+	tier = string(input.Tier)
+	if input.Family != nil {
+		family = *input.Family
+	}
 
-	sku := []interface{}{values}
-	return sku
+	return []interface{}{
+		map[string]interface{}{
+			"name":     name,
+			"capacity": capacity,
+			"tier":     tier,
+			"family":   family,
+		},
+	}
 }
 
-func flattenMySQLStorageProfile(d *schema.ResourceData, resp *mysql.StorageProfile) []interface{} {
-	values := map[string]interface{}{}
+func flattenMySQLStorageProfile(input *mysql.StorageProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
 
-	values["storageMB"] = int(*resp.StorageMB)
-	values["backupRetentionDays"] = int(*resp.BackupRetentionDays)
-	values["geoRedundantBackup"] = mysql.GeoRedundantBackup(resp.GeoRedundantBackup)
+	var storageMB, backupRetentionDays int
+	if input.StorageMB != nil {
+		storageMB = int(*input.StorageMB)
+	}
+	if input.BackupRetentionDays != nil {
+		backupRetentionDays = int(*input.BackupRetentionDays)
+	}
 
-	storageprofile := []interface{}{values}
-	return storageprofile
+	return []interface{}{
+		map[string]interface{}{
+			"storage_mb":            storageMB,
+			"backup_retention_days": backupRetentionDays,
+			"geo_redundant_backup":  string(input.GeoRedundantBackup),
+		},
+	}
 }
@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2022-09-30-preview/mysqlflexibleservers"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMySqlServerBackupExport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMySqlServerBackupExportCreate,
+		Read:   resourceArmMySqlServerBackupExportRead,
+		Delete: resourceArmMySqlServerBackupExportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureResourceID,
+			},
+
+			"backup_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"backup_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "None",
+							ValidateFunc: validation.StringInSlice([]string{
+								"Collatz",
+								"None",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"target_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_source_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AzureBlob",
+							}, false),
+						},
+
+						"sas_uri_secret_url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"storage_account_resource_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAzureResourceID,
+						},
+
+						"container_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"backup_metadata": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"data_transferred_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMySqlServerBackupExportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).mysqlServerBackupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM MySQL Server Backup Export creation.")
+
+	serverID := d.Get("server_id").(string)
+	id, err := parseAzureResourceID(serverID)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["flexibleServers"]
+
+	backupSettings := expandMySQLServerBackupSettings(d.Get("backup_settings").([]interface{}))
+	targetDetails := expandMySQLServerBackupTargetDetails(d.Get("target_details").([]interface{}))
+
+	parameters := mysqlflexibleservers.BackupRequestBase{
+		BackupSettings: backupSettings,
+		TargetDetails:  targetDetails,
+	}
+
+	future, err := client.BackupAndExport(ctx, resourceGroup, serverName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error requesting backup and export of MySQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for backup and export of MySQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving result of backup and export of MySQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	backupName := d.Get("backup_settings.0.backup_name").(string)
+	d.SetId(fmt.Sprintf("%s/backupExports/%s", serverID, backupName))
+
+	if props := result.BackupAndExportResponseProperties; props != nil {
+		d.Set("backup_metadata", props.BackupMetadata)
+		d.Set("status", props.Status)
+		if props.DataTransferredInBytes != nil {
+			d.Set("data_transferred_in_bytes", int(*props.DataTransferredInBytes))
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMySqlServerBackupExportRead(d *schema.ResourceData, meta interface{}) error {
+	// The backup-and-export operation is a one-shot action with no corresponding GET -
+	// the computed attributes captured at Create time are all there is to read back.
+	return nil
+}
+
+func resourceArmMySqlServerBackupExportDelete(d *schema.ResourceData, meta interface{}) error {
+	// There's nothing to delete server-side - the export already ran - so just drop it from state.
+	return nil
+}
+
+func expandMySQLServerBackupSettings(input []interface{}) *mysqlflexibleservers.BackupSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &mysqlflexibleservers.BackupSettings{
+		BackupName:   utils.String(v["backup_name"].(string)),
+		BackupFormat: mysqlflexibleservers.BackupFormat(v["backup_format"].(string)),
+	}
+}
+
+func expandMySQLServerBackupTargetDetails(input []interface{}) *mysqlflexibleservers.TargetDetails {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	details := mysqlflexibleservers.TargetDetails{
+		DataSourceType: mysqlflexibleservers.DataSourceType(v["data_source_type"].(string)),
+	}
+
+	if sasURI, ok := v["sas_uri_secret_url"]; ok && sasURI.(string) != "" {
+		details.SasURIIdentifier = utils.String(sasURI.(string))
+	}
+
+	if storageAccountID, ok := v["storage_account_resource_id"]; ok && storageAccountID.(string) != "" {
+		details.StorageAccountResourceID = utils.String(storageAccountID.(string))
+	}
+
+	if containerName, ok := v["container_name"]; ok && containerName.(string) != "" {
+		details.ContainerName = utils.String(containerName.(string))
+	}
+
+	return &details
+}
@@ -0,0 +1,78 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMMySqlServerBackupExport_basic(t *testing.T) {
+	resourceName := "azurerm_mysql_server_backup_export.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySqlServerBackupExport_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMMySqlServerBackupExport_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                          = "acctest-fs-%d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = azurerm_resource_group.test.location
+  administrator_login           = "acctestun"
+  administrator_login_password  = "H@Sh1CoR3!"
+  sku_name                      = "B_Standard_B1s"
+
+  storage {
+    storage_size_gb = 20
+  }
+}
+
+resource "azurerm_mysql_server_backup_export" "test" {
+  server_id = azurerm_mysql_flexible_server.test.id
+
+  backup_settings {
+    backup_name = "acctest-backup-%d"
+  }
+
+  target_details {
+    data_source_type             = "AzureBlob"
+    storage_account_resource_id  = azurerm_storage_account.test.id
+    container_name               = azurerm_storage_container.test.name
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,128 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2017-12-01/mysql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandMySQLServerSku(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name":     "GP_Gen5_2",
+			"capacity": 2,
+			"tier":     "GeneralPurpose",
+			"family":   "Gen5",
+		},
+	}
+
+	sku, err := expandMySQLServerSku(input)
+	if err != nil {
+		t.Fatalf("expected no error, got %+v", err)
+	}
+
+	if sku.Name == nil || *sku.Name != "GP_Gen5_2" {
+		t.Fatalf("expected sku name to be %q, got %+v", "GP_Gen5_2", sku.Name)
+	}
+	if sku.Capacity == nil || *sku.Capacity != 2 {
+		t.Fatalf("expected sku capacity to be 2, got %+v", sku.Capacity)
+	}
+}
+
+func TestExpandMySQLServerSku_empty(t *testing.T) {
+	if _, err := expandMySQLServerSku([]interface{}{}); err == nil {
+		t.Fatalf("expected an error for an empty sku list")
+	}
+}
+
+func TestExpandMySQLStorageProfile(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"storage_mb":            51200,
+			"backup_retention_days": 14,
+			"geo_redundant_backup":  "Enabled",
+		},
+	}
+
+	profile, err := expandMySQLStorageProfile(input)
+	if err != nil {
+		t.Fatalf("expected no error, got %+v", err)
+	}
+
+	if profile.StorageMB == nil || *profile.StorageMB != 51200 {
+		t.Fatalf("expected storage_mb to be 51200, got %+v", profile.StorageMB)
+	}
+	if profile.BackupRetentionDays == nil || *profile.BackupRetentionDays != 14 {
+		t.Fatalf("expected backup_retention_days to be 14, got %+v", profile.BackupRetentionDays)
+	}
+	if profile.GeoRedundantBackup != mysql.GeoRedundantBackup("Enabled") {
+		t.Fatalf("expected geo_redundant_backup to be Enabled, got %+v", profile.GeoRedundantBackup)
+	}
+}
+
+func TestExpandMySQLStorageProfile_empty(t *testing.T) {
+	if _, err := expandMySQLStorageProfile([]interface{}{}); err == nil {
+		t.Fatalf("expected an error for an empty storage_profile list")
+	}
+}
+
+func TestFlattenMySQLServerSku(t *testing.T) {
+	sku := &mysql.Sku{
+		Name:     utils.String("GP_Gen5_2"),
+		Tier:     mysql.GeneralPurpose,
+		Capacity: utils.Int32(2),
+		Family:   utils.String("Gen5"),
+	}
+
+	flattened := flattenMySQLServerSku(sku)
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 element, got %d", len(flattened))
+	}
+
+	values := flattened[0].(map[string]interface{})
+	if values["name"] != "GP_Gen5_2" {
+		t.Fatalf("expected name to be GP_Gen5_2, got %+v", values["name"])
+	}
+	if values["capacity"] != 2 {
+		t.Fatalf("expected capacity to be 2, got %+v", values["capacity"])
+	}
+}
+
+func TestFlattenMySQLServerSku_nil(t *testing.T) {
+	flattened := flattenMySQLServerSku(nil)
+	if len(flattened) != 0 {
+		t.Fatalf("expected 0 elements, got %d", len(flattened))
+	}
+}
+
+func TestFlattenMySQLStorageProfile(t *testing.T) {
+	profile := &mysql.StorageProfile{
+		StorageMB:           utils.Int32(51200),
+		BackupRetentionDays: utils.Int32(14),
+		GeoRedundantBackup:  mysql.GeoRedundantBackup("Enabled"),
+	}
+
+	flattened := flattenMySQLStorageProfile(profile)
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 element, got %d", len(flattened))
+	}
+
+	values := flattened[0].(map[string]interface{})
+	if values["storage_mb"] != 51200 {
+		t.Fatalf("expected storage_mb to be 51200, got %+v", values["storage_mb"])
+	}
+	if values["backup_retention_days"] != 14 {
+		t.Fatalf("expected backup_retention_days to be 14, got %+v", values["backup_retention_days"])
+	}
+	if values["geo_redundant_backup"] != "Enabled" {
+		t.Fatalf("expected geo_redundant_backup to be Enabled, got %+v", values["geo_redundant_backup"])
+	}
+}
+
+func TestFlattenMySQLStorageProfile_nil(t *testing.T) {
+	flattened := flattenMySQLStorageProfile(nil)
+	if len(flattened) != 0 {
+		t.Fatalf("expected 0 elements, got %d", len(flattened))
+	}
+}
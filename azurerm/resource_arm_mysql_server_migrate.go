@@ -0,0 +1,62 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceAzureRMMySqlServerMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM MySQL Server State v0; migrating to v1")
+		return migrateAzureRMMySqlServerStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func migrateAzureRMMySqlServerStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] ARM MySQL Server Attributes before Migration: %#v", is.Attributes)
+
+	// "storage_profile" is a TypeSet, so its elements are keyed by a hash rather
+	// than a fixed index (e.g. "storage_profile.1234567.backupRetentionDays") -
+	// match on the attribute suffix instead of hardcoding the index.
+	storageProfileRenames := map[string]string{
+		"backupRetentionDays": "backup_retention_days",
+		"georedundantbackup":  "geo_redundant_backup",
+	}
+
+	for key := range is.Attributes {
+		if !strings.HasPrefix(key, "storage_profile.") {
+			continue
+		}
+
+		for oldSuffix, newSuffix := range storageProfileRenames {
+			if !strings.HasSuffix(key, "."+oldSuffix) {
+				continue
+			}
+
+			newKey := strings.TrimSuffix(key, oldSuffix) + newSuffix
+			is.Attributes[newKey] = is.Attributes[key]
+			delete(is.Attributes, key)
+			break
+		}
+	}
+
+	if value, ok := is.Attributes["createmode"]; ok {
+		delete(is.Attributes, "createmode")
+		is.Attributes["create_mode"] = value
+	}
+
+	log.Printf("[DEBUG] ARM MySQL Server Attributes after Migration: %#v", is.Attributes)
+
+	return is, nil
+}
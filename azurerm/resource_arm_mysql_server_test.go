@@ -0,0 +1,265 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMySqlServer_basic(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySqlServer_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySqlServerExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMySqlServer_pointInTimeRestore(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	restoreResourceName := "azurerm_mysql_server.restore"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySqlServer_pointInTimeRestore(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySqlServerExists(resourceName),
+					testCheckAzureRMMySqlServerExists(restoreResourceName),
+					resource.TestCheckResourceAttr(restoreResourceName, "create_mode", "PointInTimeRestore"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMySqlServer_geoRestore(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	restoreResourceName := "azurerm_mysql_server.restore"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySqlServer_geoRestore(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySqlServerExists(resourceName),
+					testCheckAzureRMMySqlServerExists(restoreResourceName),
+					resource.TestCheckResourceAttr(restoreResourceName, "create_mode", "GeoRestore"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMySqlServer_replica(t *testing.T) {
+	resourceName := "azurerm_mysql_server.test"
+	replicaResourceName := "azurerm_mysql_server.replica"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMySqlServer_replica(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMySqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMySqlServerExists(resourceName),
+					testCheckAzureRMMySqlServerExists(replicaResourceName),
+					resource.TestCheckResourceAttr(replicaResourceName, "create_mode", "Replica"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMySqlServerExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).mysqlServersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: MySQL Server %q (Resource Group %q) does not exist", serverName, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on mysqlServersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMySqlServerDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).mysqlServersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mysql_server" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("MySQL Server still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMySqlServer_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_mysql_server" "test" {
+  name                = "acctestmysqlsvr-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "B_Gen5_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb = 51200
+  }
+
+  administrator_login          = "acctestun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "5.7"
+  ssl_enforcement               = "Enabled"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMySqlServer_pointInTimeRestore(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_server" "restore" {
+  name                = "acctestmysqlsvr-%d-restore"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "B_Gen5_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb = 51200
+  }
+
+  version         = "5.7"
+  ssl_enforcement = "Enabled"
+
+  create_mode             = "PointInTimeRestore"
+  source_server_id        = azurerm_mysql_server.test.id
+  restore_point_in_time   = "2021-01-01T00:00:00Z"
+}
+`, testAccAzureRMMySqlServer_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMMySqlServer_geoRestore(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_server" "restore" {
+  name                = "acctestmysqlsvr-%d-restore"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "B_Gen5_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen5"
+  }
+
+  storage_profile {
+    storage_mb = 51200
+  }
+
+  version         = "5.7"
+  ssl_enforcement = "Enabled"
+
+  create_mode      = "GeoRestore"
+  source_server_id = azurerm_mysql_server.test.id
+}
+`, testAccAzureRMMySqlServer_basic(rInt, location), rInt)
+}
+
+func testAccAzureRMMySqlServer_replica(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_server" "replica" {
+  name                = "acctestmysqlsvr-%d-replica"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "B_Gen5_2"
+    capacity = 2
+    tier     = "Basic"
+    family   = "Gen5"
+  }
+
+  ssl_enforcement = "Enabled"
+
+  create_mode      = "Replica"
+  source_server_id = azurerm_mysql_server.test.id
+}
+`, testAccAzureRMMySqlServer_basic(rInt, location), rInt)
+}